@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	accessLogFormatTypeJSON = "json"
+	accessLogFormatTypeXML  = "xml"
+
+	// accessLogFormatMaxLength bounds the marshaled format string. CloudWatch Logs
+	// silently truncates an access log line beyond this size, which otherwise shows
+	// up only at request time as a garbled log entry.
+	accessLogFormatMaxLength = 4096
+
+	// accessLogCustomFieldPrefix lets a format_fields value bypass the documented
+	// $context./$stage./$identity. variable validation, for callers who need a
+	// variable this provider version doesn't yet know about.
+	accessLogCustomFieldPrefix = "custom:"
+)
+
+// accessLogXMLNamePattern restricts format_fields keys that are used as XML element names
+// under format_type "xml" to the common, unambiguous subset of the XML Name production
+// (starts with a letter or underscore, followed by letters, digits, underscores, hyphens,
+// or periods). format_fields keys aren't otherwise validated, so a key containing a space
+// or other character invalid in an XML tag would silently produce malformed XML.
+var accessLogXMLNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// accessLogContextVariables is the documented set of API Gateway v2 $context./$stage./$identity.
+// variables supported in access_log_settings.format. It is not exhaustive of every variable AWS
+// may add, which is why format_fields supports the "custom:" escape hatch.
+var accessLogContextVariables = map[string]struct{}{
+	"$context.accountId":               {},
+	"$context.apiId":                   {},
+	"$context.authorizer.error":        {},
+	"$context.awsEndpointRequestId":    {},
+	"$context.domainName":              {},
+	"$context.domainPrefix":            {},
+	"$context.error.message":           {},
+	"$context.error.messageString":     {},
+	"$context.error.responseType":      {},
+	"$context.extendedRequestId":       {},
+	"$context.httpMethod":              {},
+	"$context.integrationErrorMessage": {},
+	"$context.integrationLatency":      {},
+	"$context.integrationStatus":       {},
+	"$context.path":                    {},
+	"$context.protocol":                {},
+	"$context.requestId":               {},
+	"$context.requestTime":             {},
+	"$context.requestTimeEpoch":        {},
+	"$context.responseLatency":         {},
+	"$context.responseLength":          {},
+	"$context.routeKey":                {},
+	"$context.status":                  {},
+	"$identity.accountId":              {},
+	"$identity.caller":                 {},
+	"$identity.sourceIp":               {},
+	"$identity.user":                   {},
+	"$identity.userAgent":              {},
+	"$identity.userArn":                {},
+	"$stage.stage":                     {},
+}
+
+func validateAccessLogFormatFieldValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, accessLogCustomFieldPrefix) {
+		if strings.TrimPrefix(value, accessLogCustomFieldPrefix) == "" {
+			errors = append(errors, fmt.Errorf("%q: %q must specify a value after the %q prefix", k, value, accessLogCustomFieldPrefix))
+		}
+		return ws, errors
+	}
+
+	if _, ok := accessLogContextVariables[value]; !ok {
+		errors = append(errors, fmt.Errorf("%q: %q is not a documented $context./$stage./$identity. variable; prefix with %q to use an undocumented one", k, value, accessLogCustomFieldPrefix))
+	}
+
+	return ws, errors
+}
+
+// expandAccessLogFormatFields marshals a format_fields map into the canonical format
+// string for the given format_type, resolving any "custom:" escaped values.
+//
+// format_type is restricted to "json" and "xml" because format_fields is a TypeMap:
+// both formats key each value by its field name, so the provider-assigned (alphabetical)
+// iteration order of the map doesn't affect the meaning of the result. Positional formats
+// like CLF/CSV have no such key, so the same map would marshal to a different, arbitrary
+// field order than the user intended; support for those would require an ordered
+// construct (e.g. a TypeList of {name, value} blocks) instead of a map.
+func expandAccessLogFormatFields(fields map[string]interface{}, formatType string) (string, error) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	resolved := make(map[string]string, len(fields))
+	for _, k := range keys {
+		resolved[k] = strings.TrimPrefix(fields[k].(string), accessLogCustomFieldPrefix)
+	}
+
+	var format string
+	switch formatType {
+	case accessLogFormatTypeXML:
+		var sb strings.Builder
+		sb.WriteString("<log>")
+		for _, k := range keys {
+			if !accessLogXMLNamePattern.MatchString(k) {
+				return "", fmt.Errorf("format_fields key %q is not a valid XML element name", k)
+			}
+
+			sb.WriteString("<" + k + ">")
+			if err := xml.EscapeText(&sb, []byte(resolved[k])); err != nil {
+				return "", err
+			}
+			sb.WriteString("</" + k + ">")
+		}
+		sb.WriteString("</log>")
+		format = sb.String()
+	default: // accessLogFormatTypeJSON
+		b, err := json.Marshal(orderedStringMap{keys: keys, values: resolved})
+		if err != nil {
+			return "", err
+		}
+		format = string(b)
+	}
+
+	if len(format) > accessLogFormatMaxLength {
+		return "", fmt.Errorf("format_fields marshals to a %d byte format string, which exceeds the %d byte limit CloudWatch Logs will accept without truncating", len(format), accessLogFormatMaxLength)
+	}
+
+	return format, nil
+}
+
+// orderedStringMap marshals to a JSON object with keys in a stable, sorted order so
+// that the generated format string is deterministic across applies.
+type orderedStringMap struct {
+	keys   []string
+	values map[string]string
+}
+
+func (m orderedStringMap) MarshalJSON() ([]byte, error) {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		vb, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		sb.Write(kb)
+		sb.WriteByte(':')
+		sb.Write(vb)
+	}
+	sb.WriteByte('}')
+	return []byte(sb.String()), nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import "testing"
+
+func TestExpandAccessLogFormatFieldsXMLEscaping(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		fields  map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "value containing reserved XML characters is escaped",
+			fields: map[string]interface{}{
+				"requestId": "<a> & \"b\"",
+			},
+			want: `<log><requestId>&lt;a&gt; &amp; &#34;b&#34;</requestId></log>`,
+		},
+		{
+			name: "custom prefix is stripped before escaping",
+			fields: map[string]interface{}{
+				"status": "custom:<failed>",
+			},
+			want: `<log><status>&lt;failed&gt;</status></log>`,
+		},
+		{
+			name: "key containing a space is rejected",
+			fields: map[string]interface{}{
+				"request id": "abc",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandAccessLogFormatFields(testCase.fields, accessLogFormatTypeXML)
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("expandAccessLogFormatFields() = nil error, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expandAccessLogFormatFields() = unexpected error: %s", err)
+			}
+			if got != testCase.want {
+				t.Errorf("expandAccessLogFormatFields() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
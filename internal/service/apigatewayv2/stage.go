@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -30,6 +32,12 @@ const (
 	defaultStageName = "$default"
 )
 
+const (
+	stageDeploymentStatusPending  = "Pending"
+	stageDeploymentStatusDeployed = "Deployed"
+	stageDeploymentStatusFailed   = "Failed"
+)
+
 // @SDKResource("aws_apigatewayv2_stage", name="Stage")
 // @Tags(identifierAttribute="arn")
 func ResourceStage() *schema.Resource {
@@ -42,6 +50,13 @@ func ResourceStage() *schema.Resource {
 			StateContext: resourceStageImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(10 * time.Minute),
+			Update:  schema.DefaultTimeout(10 * time.Minute),
+			Delete:  schema.DefaultTimeout(10 * time.Minute),
+			Default: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"access_log_settings": {
 				Type:     schema.TypeList,
@@ -56,8 +71,28 @@ func ResourceStage() *schema.Resource {
 							ValidateFunc: verify.ValidARN,
 						},
 						"format": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"access_log_settings.0.format_fields"},
+							AtLeastOneOf:  []string{"access_log_settings.0.format", "access_log_settings.0.format_fields"},
+						},
+						"format_fields": {
+							Type:          schema.TypeMap,
+							Optional:      true,
+							ConflictsWith: []string{"access_log_settings.0.format"},
+							AtLeastOneOf:  []string{"access_log_settings.0.format", "access_log_settings.0.format_fields"},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAccessLogFormatFieldValue,
+							},
+						},
+						"format_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      accessLogFormatTypeJSON,
+							Description:  "Format of the access log string. Only used to build the format string from format_fields; ignored if format is set directly. Only \"json\" and \"xml\" are supported: format_fields is a map, so CLF and CSV (which are positional, not keyed) can't be generated from it without an ordered construct such as a list of {name, value} blocks.",
+							ValidateFunc: validation.StringInSlice([]string{accessLogFormatTypeJSON, accessLogFormatTypeXML}, false),
 						},
 					},
 				},
@@ -115,6 +150,11 @@ func ResourceStage() *schema.Resource {
 					},
 				},
 			},
+			"deployment_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
 			"deployment_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -133,16 +173,26 @@ func ResourceStage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_deployment_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
-			"route_settings": {
-				Type:     schema.TypeSet,
+			"redeploy_on_change": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				MinItems: 0,
+				Default:  false,
+			},
+			"route_settings": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MinItems:    0,
+				Description: "Manages the full set of per-route settings for this stage. Every route_key on the stage is read back into this attribute, including route_keys managed with aws_apigatewayv2_stage_method_settings, and an update removes (via DeleteRouteSettings) any route_key that is in state but not in configuration. No diff suppression is applied for route_keys managed by aws_apigatewayv2_stage_method_settings, so using both resources on the same stage gives plans that do not converge.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"data_trace_enabled": {
@@ -181,6 +231,16 @@ func ResourceStage() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			// triggers is only consulted when redeploy_on_change is true and auto_deploy is
+			// false: a change to any value here then causes Update to call CreateDeployment
+			// and point the stage at the new deployment, instead of requiring a separate
+			// aws_apigatewayv2_deployment
+			// with its own create_before_destroy/triggers wiring.
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -211,7 +271,11 @@ func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:       getTagsIn(ctx),
 	}
 	if v, ok := d.GetOk("access_log_settings"); ok {
-		req.AccessLogSettings = expandAccessLogSettings(v.([]interface{}))
+		accessLogSettings, err := expandAccessLogSettings(v.([]interface{}))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding access_log_settings: %s", err)
+		}
+		req.AccessLogSettings = accessLogSettings
 	}
 	if v, ok := d.GetOk("client_certificate_id"); ok {
 		req.ClientCertificateId = aws.String(v.(string))
@@ -221,6 +285,12 @@ func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	if v, ok := d.GetOk("deployment_id"); ok {
 		req.DeploymentId = aws.String(v.(string))
+	} else if shouldRedeployStage(d.Get("redeploy_on_change").(bool), d.Get("auto_deploy").(bool)) {
+		deploymentId, err := createStageDeployment(ctx, conn, apiId, d.Get("deployment_description").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating API Gateway v2 deployment: %s", err)
+		}
+		req.DeploymentId = aws.String(deploymentId)
 	}
 	if v, ok := d.GetOk("description"); ok {
 		req.Description = aws.String(v.(string))
@@ -240,6 +310,12 @@ func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.ToString(resp.StageName))
 
+	if aws.ToBool(req.AutoDeploy) || req.DeploymentId != nil {
+		if _, err := waitStageDeployed(ctx, conn, apiId, d.Id(), aws.ToString(req.DeploymentId), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 stage (%s) deployment: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceStageRead(ctx, d, meta)...)
 }
 
@@ -290,6 +366,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		Resource:  fmt.Sprintf("%s/%s", apiId, stageName),
 	}.String()
 	d.Set("execution_arn", executionArn)
+	d.Set("last_deployment_status_message", resp.LastDeploymentStatusMessage)
 	d.Set("name", stageName)
 	err = d.Set("route_settings", flattenRouteSettings(resp.RouteSettings))
 	if err != nil {
@@ -329,7 +406,7 @@ func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChanges("access_log_settings", "auto_deploy", "client_certificate_id",
 		"default_route_settings", "deployment_id", "description",
-		"route_settings", "stage_variables") {
+		"route_settings", "stage_variables", "triggers") {
 		apiId := d.Get("api_id").(string)
 
 		apiOutput, err := conn.GetApi(ctx, &apigatewayv2.GetApiInput{
@@ -346,7 +423,11 @@ func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			StageName: aws.String(d.Id()),
 		}
 		if d.HasChange("access_log_settings") {
-			req.AccessLogSettings = expandAccessLogSettings(d.Get("access_log_settings").([]interface{}))
+			accessLogSettings, err := expandAccessLogSettings(d.Get("access_log_settings").([]interface{}))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "expanding access_log_settings: %s", err)
+			}
+			req.AccessLogSettings = accessLogSettings
 		}
 		if d.HasChange("auto_deploy") {
 			req.AutoDeploy = aws.Bool(d.Get("auto_deploy").(bool))
@@ -360,6 +441,13 @@ func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		if d.HasChange("deployment_id") {
 			req.DeploymentId = aws.String(d.Get("deployment_id").(string))
 		}
+		if d.HasChange("triggers") && shouldRedeployStage(d.Get("redeploy_on_change").(bool), d.Get("auto_deploy").(bool)) {
+			deploymentId, err := createStageDeployment(ctx, conn, apiId, d.Get("deployment_description").(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating API Gateway v2 deployment: %s", err)
+			}
+			req.DeploymentId = aws.String(deploymentId)
+		}
 		if d.HasChange("description") {
 			req.Description = aws.String(d.Get("description").(string))
 		}
@@ -406,6 +494,12 @@ func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 stage (%s): %s", d.Id(), err)
 		}
+
+		if d.Get("auto_deploy").(bool) || req.DeploymentId != nil {
+			if _, err := waitStageDeployed(ctx, conn, apiId, d.Id(), aws.ToString(req.DeploymentId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 stage (%s) deployment: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceStageRead(ctx, d, meta)...)
@@ -459,22 +553,129 @@ func resourceStageImport(ctx context.Context, d *schema.ResourceData, meta inter
 	return []*schema.ResourceData{d}, nil
 }
 
-func expandAccessLogSettings(vSettings []interface{}) *awstypes.AccessLogSettings {
+// statusStageDeployment polls GetStage until the stage's deployment has rolled out: either
+// targetDeploymentId (when non-empty) matches the stage's current DeploymentId, or, absent a
+// specific target (e.g. a plain auto_deploy create with no explicit deployment_id),
+// LastDeploymentStatusMessage itself reports a terminal success or failure rather than an
+// in-progress rollout.
+func statusStageDeployment(ctx context.Context, conn *apigatewayv2.Client, apiId, stageName, targetDeploymentId string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetStage(ctx, &apigatewayv2.GetStageInput{
+			ApiId:     aws.String(apiId),
+			StageName: aws.String(stageName),
+		})
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := stageDeploymentStatus(aws.ToString(output.LastDeploymentStatusMessage), aws.ToString(output.DeploymentId), targetDeploymentId)
+
+		return output, status, nil
+	}
+}
+
+// stageDeploymentStatus is the pure decision logic behind statusStageDeployment's refresh
+// function: given the stage's current LastDeploymentStatusMessage and DeploymentId, decide
+// whether the rollout targetDeploymentId (or, if empty, any rollout) is done, failed, or
+// still pending.
+func stageDeploymentStatus(message, deploymentId, targetDeploymentId string) string {
+	message = strings.ToLower(message)
+	if strings.Contains(message, "fail") {
+		return stageDeploymentStatusFailed
+	}
+
+	if targetDeploymentId != "" {
+		if deploymentId != targetDeploymentId {
+			return stageDeploymentStatusPending
+		}
+		return stageDeploymentStatusDeployed
+	}
+
+	// No specific deployment ID to match against (e.g. a plain auto_deploy create), so
+	// the rollout is only done once the API reports an explicit success -- the mere
+	// absence of a failure message doesn't mean the deployment has finished.
+	if strings.Contains(message, "succeeded") || strings.Contains(message, "successfully") {
+		return stageDeploymentStatusDeployed
+	}
+
+	return stageDeploymentStatusPending
+}
+
+// waitStageDeployed waits for a stage's deployment to finish rolling out, surfacing the
+// terminal LastDeploymentStatusMessage in the returned error if the deployment failed.
+func waitStageDeployed(ctx context.Context, conn *apigatewayv2.Client, apiId, stageName, targetDeploymentId string, timeout time.Duration) (*apigatewayv2.GetStageOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{stageDeploymentStatusPending},
+		Target:  []string{stageDeploymentStatusDeployed, stageDeploymentStatusFailed},
+		Refresh: statusStageDeployment(ctx, conn, apiId, stageName, targetDeploymentId),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*apigatewayv2.GetStageOutput); ok {
+		if message := aws.ToString(output.LastDeploymentStatusMessage); strings.Contains(strings.ToLower(message), "fail") {
+			return output, fmt.Errorf("API Gateway v2 stage (%s) deployment failed: %s", stageName, message)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// shouldRedeployStage reports whether Create/Update should mint a new deployment itself via
+// createStageDeployment. auto_deploy already keeps the stage in sync with the API's latest
+// deployment on its own, so redeploy_on_change only needs to act when auto_deploy is off.
+func shouldRedeployStage(redeployOnChange, autoDeploy bool) bool {
+	return redeployOnChange && !autoDeploy
+}
+
+// createStageDeployment creates a new API Gateway v2 deployment for use as a stage's
+// deployment_id, reconciling redeploy_on_change's triggers into a real deployment in the
+// same create/update call instead of requiring a separate aws_apigatewayv2_deployment.
+func createStageDeployment(ctx context.Context, conn *apigatewayv2.Client, apiId, description string) (string, error) {
+	req := &apigatewayv2.CreateDeploymentInput{
+		ApiId: aws.String(apiId),
+	}
+	if description != "" {
+		req.Description = aws.String(description)
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 deployment: %+v", req)
+	resp, err := conn.CreateDeployment(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(resp.DeploymentId), nil
+}
+
+func expandAccessLogSettings(vSettings []interface{}) (*awstypes.AccessLogSettings, error) {
 	settings := &awstypes.AccessLogSettings{}
 
 	if len(vSettings) == 0 || vSettings[0] == nil {
-		return settings
+		return settings, nil
 	}
 	mSettings := vSettings[0].(map[string]interface{})
 
 	if vDestinationArn, ok := mSettings["destination_arn"].(string); ok && vDestinationArn != "" {
 		settings.DestinationArn = aws.String(vDestinationArn)
 	}
-	if vFormat, ok := mSettings["format"].(string); ok && vFormat != "" {
+	if vFormatFields, ok := mSettings["format_fields"].(map[string]interface{}); ok && len(vFormatFields) > 0 {
+		format, err := expandAccessLogFormatFields(vFormatFields, mSettings["format_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		settings.Format = aws.String(format)
+	} else if vFormat, ok := mSettings["format"].(string); ok && vFormat != "" {
 		settings.Format = aws.String(vFormat)
 	}
 
-	return settings
+	return settings, nil
 }
 
 func flattenAccessLogSettings(settings *awstypes.AccessLogSettings) []interface{} {
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_apigatewayv2_stage_method_settings", name="Stage Method Settings")
+func ResourceStageMethodSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStageMethodSettingsUpsert,
+		ReadWithoutTimeout:   resourceStageMethodSettingsRead,
+		UpdateWithoutTimeout: resourceStageMethodSettingsUpsert,
+		DeleteWithoutTimeout: resourceStageMethodSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_trace_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"detailed_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"logging_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.LoggingLevelError, awstypes.LoggingLevelInfo, awstypes.LoggingLevelOff), false),
+						},
+						"throttling_burst_limit": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"throttling_rate_limit": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"stage_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceStageMethodSettingsUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+	apiId := d.Get("api_id").(string)
+	stageName := d.Get("stage_name").(string)
+	routeKey := d.Get("route_key").(string)
+
+	req := &apigatewayv2.UpdateStageInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(stageName),
+		RouteSettings: map[string]awstypes.RouteSettings{
+			routeKey: expandStageMethodSettingsRouteSettings(d.Get("settings").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 stage method settings: %+v", req)
+	_, err := conn.UpdateStage(ctx, req)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 stage (%s) method settings (%s): %s", stageName, routeKey, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", apiId, stageName, routeKey))
+
+	return append(diags, resourceStageMethodSettingsRead(ctx, d, meta)...)
+}
+
+func resourceStageMethodSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+	apiId, stageName, routeKey, err := stageMethodSettingsParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resp, err := conn.GetStage(ctx, &apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(stageName),
+	})
+	if errs.IsA[*awstypes.NotFoundException](err) && !d.IsNewResource() {
+		log.Printf("[WARN] API Gateway v2 stage (%s) not found, removing stage method settings (%s) from state", stageName, d.Id())
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 stage (%s): %s", stageName, err)
+	}
+
+	routeSettings, ok := resp.RouteSettings[routeKey]
+	if !ok && !d.IsNewResource() {
+		log.Printf("[WARN] API Gateway v2 stage (%s) route settings (%s) not found, removing from state", stageName, routeKey)
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("api_id", apiId)
+	d.Set("route_key", routeKey)
+	d.Set("stage_name", stageName)
+	if err := d.Set("settings", flattenStageMethodSettingsRouteSettings(routeSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+
+	return diags
+}
+
+func resourceStageMethodSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+	apiId := d.Get("api_id").(string)
+	stageName := d.Get("stage_name").(string)
+	routeKey := d.Get("route_key").(string)
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 stage (%s) method settings (%s)", stageName, routeKey)
+	_, err := conn.DeleteRouteSettings(ctx, &apigatewayv2.DeleteRouteSettingsInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(stageName),
+		RouteKey:  aws.String(routeKey),
+	})
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway v2 stage (%s) method settings (%s): %s", stageName, routeKey, err)
+	}
+
+	return diags
+}
+
+// stageMethodSettingsParseID splits an ID of the form "api-id/stage-name/route-key" into
+// its parts. route_key is taken as everything after the second separator, since route
+// keys themselves commonly contain "/" (e.g. "GET /pets/{id}").
+func stageMethodSettingsParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected api-id/stage-name/route-key", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func expandStageMethodSettingsRouteSettings(vSettings []interface{}) awstypes.RouteSettings {
+	routeSettings := awstypes.RouteSettings{}
+
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return routeSettings
+	}
+	mSettings := vSettings[0].(map[string]interface{})
+
+	if v, ok := mSettings["data_trace_enabled"].(bool); ok {
+		routeSettings.DataTraceEnabled = aws.Bool(v)
+	}
+	if v, ok := mSettings["detailed_metrics_enabled"].(bool); ok {
+		routeSettings.DetailedMetricsEnabled = aws.Bool(v)
+	}
+	if v, ok := mSettings["logging_level"].(string); ok && v != "" {
+		routeSettings.LoggingLevel = awstypes.LoggingLevel(v)
+	}
+	if v, ok := mSettings["throttling_burst_limit"].(int); ok {
+		routeSettings.ThrottlingBurstLimit = aws.Int32(int32(v))
+	}
+	if v, ok := mSettings["throttling_rate_limit"].(float64); ok {
+		routeSettings.ThrottlingRateLimit = aws.Float64(v)
+	}
+
+	return routeSettings
+}
+
+func flattenStageMethodSettingsRouteSettings(routeSettings awstypes.RouteSettings) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"data_trace_enabled":       aws.ToBool(routeSettings.DataTraceEnabled),
+		"detailed_metrics_enabled": aws.ToBool(routeSettings.DetailedMetricsEnabled),
+		"logging_level":            string(routeSettings.LoggingLevel),
+		"throttling_burst_limit":   int(aws.ToInt32(routeSettings.ThrottlingBurstLimit)),
+		"throttling_rate_limit":    aws.ToFloat64(routeSettings.ThrottlingRateLimit),
+	}}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayV2StageMethodSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigatewayv2.GetStageOutput
+	resourceName := "aws_apigatewayv2_stage_method_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageMethodSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageMethodSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStageMethodSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "route_key", "GET /pets"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.throttling_burst_limit", "10"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.throttling_rate_limit", "5.5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStageMethodSettingsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStageMethodSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.throttling_burst_limit", "20"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.throttling_rate_limit", "11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStageMethodSettingsExists(ctx context.Context, n string, v *apigatewayv2.GetStageOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		apiId := rs.Primary.Attributes["api_id"]
+		stageName := rs.Primary.Attributes["stage_name"]
+		routeKey := rs.Primary.Attributes["route_key"]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+		output, err := conn.GetStage(ctx, &apigatewayv2.GetStageInput{
+			ApiId:     aws.String(apiId),
+			StageName: aws.String(stageName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, ok := output.RouteSettings[routeKey]; !ok {
+			return fmt.Errorf("API Gateway v2 stage (%s) has no route settings for route key (%s)", stageName, routeKey)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStageMethodSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_apigatewayv2_stage_method_settings" {
+				continue
+			}
+
+			apiId := rs.Primary.Attributes["api_id"]
+			stageName := rs.Primary.Attributes["stage_name"]
+			routeKey := rs.Primary.Attributes["route_key"]
+
+			output, err := conn.GetStage(ctx, &apigatewayv2.GetStageInput{
+				ApiId:     aws.String(apiId),
+				StageName: aws.String(stageName),
+			})
+			if errs.IsA[*awstypes.NotFoundException](err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if _, ok := output.RouteSettings[routeKey]; !ok {
+				continue
+			}
+
+			return fmt.Errorf("API Gateway v2 stage (%s) route settings (%s) still exist", stageName, routeKey)
+		}
+
+		return nil
+	}
+}
+
+func testAccStageMethodSettingsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /pets"
+  target    = "integrations/${aws_apigatewayv2_integration.test.id}"
+}
+
+resource "aws_apigatewayv2_integration" "test" {
+  api_id             = aws_apigatewayv2_api.test.id
+  integration_type   = "MOCK"
+  integration_method = "GET"
+}
+
+resource "aws_apigatewayv2_stage" "test" {
+  api_id      = aws_apigatewayv2_api.test.id
+  name        = "test"
+  auto_deploy = true
+
+  depends_on = [aws_apigatewayv2_route.test]
+}
+`, rName)
+}
+
+func testAccStageMethodSettingsConfig_basic(rName string) string {
+	return testAccStageMethodSettingsConfig_base(rName) + `
+resource "aws_apigatewayv2_stage_method_settings" "test" {
+  api_id     = aws_apigatewayv2_api.test.id
+  stage_name = aws_apigatewayv2_stage.test.name
+  route_key  = aws_apigatewayv2_route.test.route_key
+
+  settings {
+    throttling_burst_limit = 10
+    throttling_rate_limit  = 5.5
+  }
+}
+`
+}
+
+func testAccStageMethodSettingsConfig_updated(rName string) string {
+	return testAccStageMethodSettingsConfig_base(rName) + `
+resource "aws_apigatewayv2_stage_method_settings" "test" {
+  api_id     = aws_apigatewayv2_api.test.id
+  stage_name = aws_apigatewayv2_stage.test.name
+  route_key  = aws_apigatewayv2_route.test.route_key
+
+  settings {
+    throttling_burst_limit = 20
+    throttling_rate_limit  = 11
+  }
+}
+`
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+func TestStageMethodSettingsParseID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		id            string
+		wantApiId     string
+		wantStageName string
+		wantRouteKey  string
+		wantErr       bool
+	}{
+		{
+			name:          "simple route key",
+			id:            "abc123/test/GET /pets",
+			wantApiId:     "abc123",
+			wantStageName: "test",
+			wantRouteKey:  "GET /pets",
+		},
+		{
+			name:          "route key containing a path parameter",
+			id:            "abc123/test/GET /pets/{id}",
+			wantApiId:     "abc123",
+			wantStageName: "test",
+			wantRouteKey:  "GET /pets/{id}",
+		},
+		{
+			name:    "missing route key",
+			id:      "abc123/test",
+			wantErr: true,
+		},
+		{
+			name:    "empty api id",
+			id:      "/test/GET /pets",
+			wantErr: true,
+		},
+		{
+			name:    "empty id",
+			id:      "",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotApiId, gotStageName, gotRouteKey, err := stageMethodSettingsParseID(testCase.id)
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("stageMethodSettingsParseID(%q) = nil error, want an error", testCase.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("stageMethodSettingsParseID(%q) = unexpected error: %s", testCase.id, err)
+			}
+			if gotApiId != testCase.wantApiId || gotStageName != testCase.wantStageName || gotRouteKey != testCase.wantRouteKey {
+				t.Errorf("stageMethodSettingsParseID(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					testCase.id, gotApiId, gotStageName, gotRouteKey, testCase.wantApiId, testCase.wantStageName, testCase.wantRouteKey)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenStageMethodSettingsRouteSettings(t *testing.T) {
+	t.Parallel()
+
+	input := []interface{}{
+		map[string]interface{}{
+			"data_trace_enabled":       true,
+			"detailed_metrics_enabled": true,
+			"logging_level":            string(awstypes.LoggingLevelInfo),
+			"throttling_burst_limit":   10,
+			"throttling_rate_limit":    5.5,
+		},
+	}
+
+	routeSettings := expandStageMethodSettingsRouteSettings(input)
+
+	got := flattenStageMethodSettingsRouteSettings(routeSettings)
+	if len(got) != 1 {
+		t.Fatalf("flattenStageMethodSettingsRouteSettings() returned %d elements, want 1", len(got))
+	}
+
+	gotSettings := got[0].(map[string]interface{})
+	wantSettings := input[0].(map[string]interface{})
+	for k, want := range wantSettings {
+		if gotSettings[k] != want {
+			t.Errorf("flattened %q = %v, want %v", k, gotSettings[k], want)
+		}
+	}
+}
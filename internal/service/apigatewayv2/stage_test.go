@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import "testing"
+
+func TestShouldRedeployStage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		redeployOnChange bool
+		autoDeploy       bool
+		want             bool
+	}{
+		{
+			name:             "redeploy_on_change set, auto_deploy off",
+			redeployOnChange: true,
+			autoDeploy:       false,
+			want:             true,
+		},
+		{
+			name:             "redeploy_on_change set, auto_deploy already handles it",
+			redeployOnChange: true,
+			autoDeploy:       true,
+			want:             false,
+		},
+		{
+			name:             "redeploy_on_change off",
+			redeployOnChange: false,
+			autoDeploy:       false,
+			want:             false,
+		},
+		{
+			name:             "redeploy_on_change off, auto_deploy set",
+			redeployOnChange: false,
+			autoDeploy:       true,
+			want:             false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := shouldRedeployStage(testCase.redeployOnChange, testCase.autoDeploy); got != testCase.want {
+				t.Errorf("shouldRedeployStage(%t, %t) = %t, want %t", testCase.redeployOnChange, testCase.autoDeploy, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestStageDeploymentStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		message            string
+		deploymentId       string
+		targetDeploymentId string
+		want               string
+	}{
+		{
+			name:               "failure message wins regardless of target",
+			message:            "Deployment FAILED to roll out",
+			deploymentId:       "abc123",
+			targetDeploymentId: "abc123",
+			want:               stageDeploymentStatusFailed,
+		},
+		{
+			name:               "target deployment id matches",
+			deploymentId:       "abc123",
+			targetDeploymentId: "abc123",
+			want:               stageDeploymentStatusDeployed,
+		},
+		{
+			name:               "target deployment id does not match yet",
+			deploymentId:       "old",
+			targetDeploymentId: "abc123",
+			want:               stageDeploymentStatusPending,
+		},
+		{
+			name:    "no target, explicit success message",
+			message: "Deployment succeeded",
+			want:    stageDeploymentStatusDeployed,
+		},
+		{
+			name:    "no target, alternate success wording",
+			message: "The deployment completed successfully",
+			want:    stageDeploymentStatusDeployed,
+		},
+		{
+			name:    "no target, no terminal message yet",
+			message: "",
+			want:    stageDeploymentStatusPending,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := stageDeploymentStatus(testCase.message, testCase.deploymentId, testCase.targetDeploymentId)
+			if got != testCase.want {
+				t.Errorf("stageDeploymentStatus(%q, %q, %q) = %q, want %q",
+					testCase.message, testCase.deploymentId, testCase.targetDeploymentId, got, testCase.want)
+			}
+		})
+	}
+}
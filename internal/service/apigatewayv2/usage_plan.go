@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigatewaytypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_apigatewayv2_usage_plan", name="Usage Plan")
+// @Tags(identifierAttribute="arn")
+func ResourceUsagePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanCreate,
+		ReadWithoutTimeout:   resourceUsagePlanRead,
+		UpdateWithoutTimeout: resourceUsagePlanUpdate,
+		DeleteWithoutTimeout: resourceUsagePlanDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_stages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"quota_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 0,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"offset": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"period": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(apigatewaytypes.QuotaPeriodTypeDay, apigatewaytypes.QuotaPeriodTypeWeek, apigatewaytypes.QuotaPeriodTypeMonth), false),
+						},
+					},
+				},
+			},
+			"throttle_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 0,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst_limit": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"rate_limit": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceUsagePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	req := &apigateway.CreateUsagePlanInput{
+		Name: aws.String(d.Get("name").(string)),
+		Tags: getTagsIn(ctx),
+	}
+	if v, ok := d.GetOk("api_stages"); ok {
+		req.ApiStages = expandUsagePlanAPIStages(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("quota_settings"); ok {
+		req.Quota = expandUsagePlanQuotaSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("throttle_settings"); ok {
+		req.Throttle = expandUsagePlanThrottleSettings(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway usage plan: %+v", req)
+	resp, err := conn.CreateUsagePlan(ctx, req)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway usage plan: %s", err)
+	}
+
+	d.SetId(aws.ToString(resp.Id))
+
+	return append(diags, resourceUsagePlanRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	resp, err := conn.GetUsagePlan(ctx, &apigateway.GetUsagePlanInput{
+		UsagePlanId: aws.String(d.Id()),
+	})
+	if errs.IsA[*apigatewaytypes.NotFoundException](err) && !d.IsNewResource() {
+		log.Printf("[WARN] API Gateway usage plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway usage plan (%s): %s", d.Id(), err)
+	}
+
+	resourceArn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "apigateway",
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("/usageplans/%s", d.Id()),
+	}.String()
+	d.Set("arn", resourceArn)
+	if err := d.Set("api_stages", flattenUsagePlanAPIStages(resp.ApiStages)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting api_stages: %s", err)
+	}
+	d.Set("description", resp.Description)
+	d.Set("name", resp.Name)
+	if err := d.Set("quota_settings", flattenUsagePlanQuotaSettings(resp.Quota)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting quota_settings: %s", err)
+	}
+	if err := d.Set("throttle_settings", flattenUsagePlanThrottleSettings(resp.Throttle)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting throttle_settings: %s", err)
+	}
+
+	setTagsOut(ctx, resp.Tags)
+
+	return diags
+}
+
+func resourceUsagePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	if d.HasChanges("api_stages", "description", "name", "quota_settings", "throttle_settings") {
+		operations := make([]apigatewaytypes.PatchOperation, 0)
+
+		if d.HasChange("name") {
+			operations = append(operations, apigatewaytypes.PatchOperation{
+				Op:    apigatewaytypes.OpReplace,
+				Path:  aws.String("/name"),
+				Value: aws.String(d.Get("name").(string)),
+			})
+		}
+		if d.HasChange("description") {
+			operations = append(operations, apigatewaytypes.PatchOperation{
+				Op:    apigatewaytypes.OpReplace,
+				Path:  aws.String("/description"),
+				Value: aws.String(d.Get("description").(string)),
+			})
+		}
+		if d.HasChange("quota_settings") {
+			operations = append(operations, expandUsagePlanQuotaSettingsOperations(d.Get("quota_settings").([]interface{}))...)
+		}
+		if d.HasChange("throttle_settings") {
+			operations = append(operations, expandUsagePlanThrottleSettingsOperations(d.Get("throttle_settings").([]interface{}))...)
+		}
+		if d.HasChange("api_stages") {
+			o, n := d.GetChange("api_stages")
+			operations = append(operations, diffUsagePlanAPIStagesOperations(o.(*schema.Set), n.(*schema.Set))...)
+		}
+
+		log.Printf("[DEBUG] Updating API Gateway usage plan: %s", d.Id())
+		_, err := conn.UpdateUsagePlan(ctx, &apigateway.UpdateUsagePlanInput{
+			UsagePlanId:     aws.String(d.Id()),
+			PatchOperations: operations,
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway usage plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUsagePlanRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	log.Printf("[DEBUG] Deleting API Gateway usage plan: %s", d.Id())
+	_, err := conn.DeleteUsagePlan(ctx, &apigateway.DeleteUsagePlanInput{
+		UsagePlanId: aws.String(d.Id()),
+	})
+	if errs.IsA[*apigatewaytypes.NotFoundException](err) {
+		return diags
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway usage plan (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandUsagePlanAPIStages(vApiStages []interface{}) []apigatewaytypes.ApiStage {
+	apiStages := make([]apigatewaytypes.ApiStage, 0, len(vApiStages))
+
+	for _, vApiStage := range vApiStages {
+		mApiStage := vApiStage.(map[string]interface{})
+
+		apiStages = append(apiStages, apigatewaytypes.ApiStage{
+			ApiId: aws.String(mApiStage["api_id"].(string)),
+			Stage: aws.String(mApiStage["stage"].(string)),
+		})
+	}
+
+	return apiStages
+}
+
+func flattenUsagePlanAPIStages(apiStages []apigatewaytypes.ApiStage) []interface{} {
+	vApiStages := make([]interface{}, 0, len(apiStages))
+
+	for _, apiStage := range apiStages {
+		vApiStages = append(vApiStages, map[string]interface{}{
+			"api_id": aws.ToString(apiStage.ApiId),
+			"stage":  aws.ToString(apiStage.Stage),
+		})
+	}
+
+	return vApiStages
+}
+
+func diffUsagePlanAPIStagesOperations(o, n *schema.Set) []apigatewaytypes.PatchOperation {
+	operations := make([]apigatewaytypes.PatchOperation, 0)
+
+	for _, vApiStage := range o.Difference(n).List() {
+		mApiStage := vApiStage.(map[string]interface{})
+
+		operations = append(operations, apigatewaytypes.PatchOperation{
+			Op:    apigatewaytypes.OpRemove,
+			Path:  aws.String("/apiStages"),
+			Value: aws.String(fmt.Sprintf("%s:%s", mApiStage["api_id"].(string), mApiStage["stage"].(string))),
+		})
+	}
+
+	for _, vApiStage := range n.Difference(o).List() {
+		mApiStage := vApiStage.(map[string]interface{})
+
+		operations = append(operations, apigatewaytypes.PatchOperation{
+			Op:    apigatewaytypes.OpAdd,
+			Path:  aws.String("/apiStages"),
+			Value: aws.String(fmt.Sprintf("%s:%s", mApiStage["api_id"].(string), mApiStage["stage"].(string))),
+		})
+	}
+
+	return operations
+}
+
+func expandUsagePlanQuotaSettings(vQuotaSettings []interface{}) *apigatewaytypes.QuotaSettings {
+	if len(vQuotaSettings) == 0 || vQuotaSettings[0] == nil {
+		return nil
+	}
+	mQuotaSettings := vQuotaSettings[0].(map[string]interface{})
+
+	return &apigatewaytypes.QuotaSettings{
+		Limit:  int32(mQuotaSettings["limit"].(int)),
+		Offset: int32(mQuotaSettings["offset"].(int)),
+		Period: apigatewaytypes.QuotaPeriodType(mQuotaSettings["period"].(string)),
+	}
+}
+
+func expandUsagePlanQuotaSettingsOperations(vQuotaSettings []interface{}) []apigatewaytypes.PatchOperation {
+	if len(vQuotaSettings) == 0 || vQuotaSettings[0] == nil {
+		return []apigatewaytypes.PatchOperation{
+			{
+				Op:   apigatewaytypes.OpRemove,
+				Path: aws.String("/quota"),
+			},
+		}
+	}
+	mQuotaSettings := vQuotaSettings[0].(map[string]interface{})
+
+	return []apigatewaytypes.PatchOperation{
+		{
+			Op:    apigatewaytypes.OpReplace,
+			Path:  aws.String("/quota/limit"),
+			Value: aws.String(fmt.Sprintf("%d", mQuotaSettings["limit"].(int))),
+		},
+		{
+			Op:    apigatewaytypes.OpReplace,
+			Path:  aws.String("/quota/offset"),
+			Value: aws.String(fmt.Sprintf("%d", mQuotaSettings["offset"].(int))),
+		},
+		{
+			Op:    apigatewaytypes.OpReplace,
+			Path:  aws.String("/quota/period"),
+			Value: aws.String(mQuotaSettings["period"].(string)),
+		},
+	}
+}
+
+func flattenUsagePlanQuotaSettings(quotaSettings *apigatewaytypes.QuotaSettings) []interface{} {
+	if quotaSettings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"limit":  int(quotaSettings.Limit),
+		"offset": int(quotaSettings.Offset),
+		"period": string(quotaSettings.Period),
+	}}
+}
+
+func expandUsagePlanThrottleSettings(vThrottleSettings []interface{}) *apigatewaytypes.ThrottleSettings {
+	if len(vThrottleSettings) == 0 || vThrottleSettings[0] == nil {
+		return nil
+	}
+	mThrottleSettings := vThrottleSettings[0].(map[string]interface{})
+
+	return &apigatewaytypes.ThrottleSettings{
+		BurstLimit: int32(mThrottleSettings["burst_limit"].(int)),
+		RateLimit:  mThrottleSettings["rate_limit"].(float64),
+	}
+}
+
+func expandUsagePlanThrottleSettingsOperations(vThrottleSettings []interface{}) []apigatewaytypes.PatchOperation {
+	if len(vThrottleSettings) == 0 || vThrottleSettings[0] == nil {
+		return []apigatewaytypes.PatchOperation{
+			{
+				Op:   apigatewaytypes.OpRemove,
+				Path: aws.String("/throttle"),
+			},
+		}
+	}
+	mThrottleSettings := vThrottleSettings[0].(map[string]interface{})
+
+	return []apigatewaytypes.PatchOperation{
+		{
+			Op:    apigatewaytypes.OpReplace,
+			Path:  aws.String("/throttle/burstLimit"),
+			Value: aws.String(fmt.Sprintf("%d", mThrottleSettings["burst_limit"].(int))),
+		},
+		{
+			Op:    apigatewaytypes.OpReplace,
+			Path:  aws.String("/throttle/rateLimit"),
+			Value: aws.String(fmt.Sprintf("%g", mThrottleSettings["rate_limit"].(float64))),
+		},
+	}
+}
+
+func flattenUsagePlanThrottleSettings(throttleSettings *apigatewaytypes.ThrottleSettings) []interface{} {
+	if throttleSettings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"burst_limit": int(throttleSettings.BurstLimit),
+		"rate_limit":  throttleSettings.RateLimit,
+	}}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigatewaytypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_apigatewayv2_usage_plan_key", name="Usage Plan Key")
+func ResourceUsagePlanKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeyCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeyRead,
+		DeleteWithoutTimeout: resourceUsagePlanKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUsagePlanKeyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanId := d.Get("usage_plan_id").(string)
+	req := &apigateway.CreateUsagePlanKeyInput{
+		UsagePlanId: aws.String(usagePlanId),
+		KeyId:       aws.String(d.Get("key_id").(string)),
+		KeyType:     aws.String(d.Get("key_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway usage plan key: %+v", req)
+	resp, err := conn.CreateUsagePlanKey(ctx, req)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway usage plan (%s) key: %s", usagePlanId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", usagePlanId, aws.ToString(resp.Id)))
+
+	return append(diags, resourceUsagePlanKeyRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanId, keyId, err := usagePlanKeyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resp, err := conn.GetUsagePlanKey(ctx, &apigateway.GetUsagePlanKeyInput{
+		UsagePlanId: aws.String(usagePlanId),
+		KeyId:       aws.String(keyId),
+	})
+	if errs.IsA[*apigatewaytypes.NotFoundException](err) && !d.IsNewResource() {
+		log.Printf("[WARN] API Gateway usage plan (%s) key (%s) not found, removing from state", usagePlanId, keyId)
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway usage plan (%s) key (%s): %s", usagePlanId, keyId, err)
+	}
+
+	d.Set("key_id", resp.Id)
+	d.Set("key_type", resp.Type)
+	d.Set("name", resp.Name)
+	d.Set("usage_plan_id", usagePlanId)
+	d.Set("value", resp.Value)
+
+	return diags
+}
+
+func resourceUsagePlanKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanId, keyId, err := usagePlanKeyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting API Gateway usage plan (%s) key (%s)", usagePlanId, keyId)
+	_, err = conn.DeleteUsagePlanKey(ctx, &apigateway.DeleteUsagePlanKeyInput{
+		UsagePlanId: aws.String(usagePlanId),
+		KeyId:       aws.String(keyId),
+	})
+	if errs.IsA[*apigatewaytypes.NotFoundException](err) {
+		return diags
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway usage plan (%s) key (%s): %s", usagePlanId, keyId, err)
+	}
+
+	return diags
+}
+
+func resourceUsagePlanKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	usagePlanId, keyId, err := usagePlanKeyParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("usage_plan_id", usagePlanId)
+	d.Set("key_id", keyId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func usagePlanKeyParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected usage-plan-id/key-id", id)
+	}
+
+	return parts[0], parts[1], nil
+}
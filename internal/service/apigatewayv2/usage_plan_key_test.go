@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigatewaytypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testAccUsagePlanKeyParseID mirrors usagePlanKeyParseID's "usage-plan-id/key-id" format
+// for use from the external _test package, which can't reach the unexported helper.
+func testAccUsagePlanKeyParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected usage-plan-id/key-id", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func TestAccAPIGatewayV2UsagePlanKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigateway.GetUsagePlanKeyOutput
+	resourceName := "aws_apigatewayv2_usage_plan_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "API_KEY"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "value"),
+					resource.TestCheckResourceAttrPair(resourceName, "usage_plan_id", "aws_apigatewayv2_usage_plan.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "aws_api_gateway_api_key.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUsagePlanKeyExists(ctx context.Context, n string, v *apigateway.GetUsagePlanKeyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		usagePlanId, keyId, err := testAccUsagePlanKeyParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		output, err := conn.GetUsagePlanKey(ctx, &apigateway.GetUsagePlanKeyInput{
+			UsagePlanId: aws.String(usagePlanId),
+			KeyId:       aws.String(keyId),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_apigatewayv2_usage_plan_key" {
+				continue
+			}
+
+			usagePlanId, keyId, err := testAccUsagePlanKeyParseID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = conn.GetUsagePlanKey(ctx, &apigateway.GetUsagePlanKeyInput{
+				UsagePlanId: aws.String(usagePlanId),
+				KeyId:       aws.String(keyId),
+			})
+			if errs.IsA[*apigatewaytypes.NotFoundException](err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("API Gateway v2 usage plan key %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccUsagePlanKeyConfig_basic(rName string) string {
+	return testAccUsagePlanConfig_base(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_apigatewayv2_api.test.id
+    stage  = aws_apigatewayv2_stage.test.name
+  }
+}
+
+resource "aws_api_gateway_api_key" "test" {
+  name = %[1]q
+}
+
+resource "aws_apigatewayv2_usage_plan_key" "test" {
+  usage_plan_id = aws_apigatewayv2_usage_plan.test.id
+  key_id        = aws_api_gateway_api_key.test.id
+  key_type      = "API_KEY"
+}
+`, rName)
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigatewaytypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayV2UsagePlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigateway.GetUsagePlanOutput
+	resourceName := "aws_apigatewayv2_usage_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "quota_settings.0.limit", "20"),
+					resource.TestCheckResourceAttr(resourceName, "quota_settings.0.period", "WEEK"),
+					resource.TestCheckResourceAttr(resourceName, "throttle_settings.0.rate_limit", "10"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "apigateway", regexache.MustCompile(`/usageplans/.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2UsagePlan_quotaEnforcement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigateway.GetUsagePlanOutput
+	resourceName := "aws_apigatewayv2_usage_plan.test"
+	apiKeyResourceName := "aws_api_gateway_api_key.test"
+	stageResourceName := "aws_apigatewayv2_stage.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// A quota_settings.limit of 1 per day makes it trivial to exhaust the quota
+				// in a single test run without racing real time.
+				Config: testAccUsagePlanConfig_quotaEnforcement(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanExists(ctx, resourceName, &v),
+					testAccCheckUsagePlanQuotaEnforced(ctx, stageResourceName, apiKeyResourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckUsagePlanQuotaEnforced drives real HTTP requests at the deployed stage's
+// invoke_url using the usage plan's API key, asserting that requests succeed up to the
+// configured quota and are throttled (HTTP 429) once it's exhausted.
+func testAccCheckUsagePlanQuotaEnforced(ctx context.Context, stageResourceName, apiKeyResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		stageRs, ok := s.RootModule().Resources[stageResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", stageResourceName)
+		}
+		apiKeyRs, ok := s.RootModule().Resources[apiKeyResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", apiKeyResourceName)
+		}
+
+		invokeURL := stageRs.Primary.Attributes["invoke_url"]
+		apiKeyValue := apiKeyRs.Primary.Attributes["value"]
+
+		doRequest := func() (int, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, invokeURL, nil)
+			if err != nil {
+				return 0, err
+			}
+			req.Header.Set("x-api-key", apiKeyValue)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
+			defer resp.Body.Close()
+
+			return resp.StatusCode, nil
+		}
+
+		// The first request consumes the one request per day this usage plan allows; it
+		// may briefly 403/404 while the deployment finishes propagating, so retry it.
+		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			status, err := doRequest()
+			if err != nil {
+				return retry.RetryableError(err)
+			}
+			if status == http.StatusForbidden || status == http.StatusNotFound {
+				return retry.RetryableError(fmt.Errorf("stage not yet serving traffic, got status %d", status))
+			}
+			if status != http.StatusOK {
+				return retry.NonRetryableError(fmt.Errorf("expected first request to succeed, got status %d", status))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		status, err := doRequest()
+		if err != nil {
+			return err
+		}
+		if status != http.StatusTooManyRequests {
+			return fmt.Errorf("expected quota to throttle the second request with status 429, got %d", status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanExists(ctx context.Context, n string, v *apigateway.GetUsagePlanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		output, err := conn.GetUsagePlan(ctx, &apigateway.GetUsagePlanInput{
+			UsagePlanId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_apigatewayv2_usage_plan" {
+				continue
+			}
+
+			_, err := conn.GetUsagePlan(ctx, &apigateway.GetUsagePlanInput{
+				UsagePlanId: aws.String(rs.Primary.ID),
+			})
+			if errs.IsA[*apigatewaytypes.NotFoundException](err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("API Gateway v2 usage plan %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccUsagePlanConfig_base's route sets api_key_required = true so that requests lacking
+// (or exceeding) a usage plan's API key are actually rejected by the gateway; without it the
+// route would serve every request regardless of quota/throttle state, and
+// testAccCheckUsagePlanQuotaEnforced's 429 assertion wouldn't be exercising anything real.
+func testAccUsagePlanConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id           = aws_apigatewayv2_api.test.id
+  route_key        = "GET /"
+  target           = "integrations/${aws_apigatewayv2_integration.test.id}"
+  api_key_required = true
+}
+
+resource "aws_apigatewayv2_integration" "test" {
+  api_id             = aws_apigatewayv2_api.test.id
+  integration_type   = "MOCK"
+  integration_method = "GET"
+}
+
+resource "aws_apigatewayv2_stage" "test" {
+  api_id      = aws_apigatewayv2_api.test.id
+  name        = "test"
+  auto_deploy = true
+
+  depends_on = [aws_apigatewayv2_route.test]
+}
+`, rName)
+}
+
+func testAccUsagePlanConfig_basic(rName string) string {
+	return testAccUsagePlanConfig_base(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_apigatewayv2_api.test.id
+    stage  = aws_apigatewayv2_stage.test.name
+  }
+
+  quota_settings {
+    limit  = 20
+    period = "WEEK"
+  }
+
+  throttle_settings {
+    burst_limit = 5
+    rate_limit  = 10
+  }
+}
+`, rName)
+}
+
+func testAccUsagePlanConfig_quotaEnforcement(rName string, quotaLimit int) string {
+	return testAccUsagePlanConfig_base(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_apigatewayv2_api.test.id
+    stage  = aws_apigatewayv2_stage.test.name
+  }
+
+  quota_settings {
+    limit  = %[2]d
+    period = "DAY"
+  }
+}
+
+resource "aws_api_gateway_api_key" "test" {
+  name = %[1]q
+}
+
+resource "aws_apigatewayv2_usage_plan_key" "test" {
+  usage_plan_id = aws_apigatewayv2_usage_plan.test.id
+  key_id        = aws_api_gateway_api_key.test.id
+  key_type      = "API_KEY"
+}
+`, rName, quotaLimit)
+}